@@ -0,0 +1,287 @@
+package sitemeta
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// extractTitle prefers the OG/Twitter title meta tags over the <title> tag,
+// since pages often use <title> for SEO boilerplate and og:title for the
+// human-readable headline.
+func (c *Client) extractTitle(metaTags []*html.Node) string {
+	for _, meta := range metaTags {
+		if len(meta.Attr) < 2 {
+			continue
+		}
+
+		if meta.Attr[0].Key == "property" && meta.Attr[0].Val == "og:title" {
+			return meta.Attr[1].Val
+		}
+
+		if meta.Attr[0].Key == "name" && meta.Attr[0].Val == "twitter:title" {
+			return meta.Attr[1].Val
+		}
+	}
+
+	return ""
+}
+
+// extractAuthor looks for the common meta tags sites use to credit an author.
+func (c *Client) extractAuthor(metaTags []*html.Node) string {
+	for _, meta := range metaTags {
+		if len(meta.Attr) < 2 {
+			continue
+		}
+
+		if meta.Attr[0].Key == "name" && meta.Attr[0].Val == "author" {
+			return meta.Attr[1].Val
+		}
+
+		if meta.Attr[0].Key == "property" && meta.Attr[0].Val == "article:author" {
+			return meta.Attr[1].Val
+		}
+
+		if meta.Attr[0].Key == "name" && meta.Attr[0].Val == "twitter:creator" {
+			return meta.Attr[1].Val
+		}
+	}
+
+	return ""
+}
+
+func (c *Client) extractSiteName(metaTags []*html.Node) string {
+	for _, meta := range metaTags {
+		if len(meta.Attr) < 2 {
+			continue
+		}
+
+		if meta.Attr[0].Key == "property" && meta.Attr[0].Val == "og:site_name" {
+			return meta.Attr[1].Val
+		}
+	}
+
+	return ""
+}
+
+func (c *Client) extractPublishedTime(metaTags []*html.Node) string {
+	for _, meta := range metaTags {
+		if len(meta.Attr) < 2 {
+			continue
+		}
+
+		if meta.Attr[0].Key == "property" && meta.Attr[0].Val == "article:published_time" {
+			return meta.Attr[1].Val
+		}
+
+		if meta.Attr[0].Key == "name" && meta.Attr[0].Val == "date" {
+			return meta.Attr[1].Val
+		}
+	}
+
+	return ""
+}
+
+func (c *Client) findLinkTags(node *html.Node) []*html.Node {
+	var linkTags []*html.Node
+
+	if node.Type == html.ElementNode && node.Data == "link" {
+		linkTags = append(linkTags, node)
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		linkTags = append(linkTags, c.findLinkTags(child)...)
+	}
+
+	return linkTags
+}
+
+func linkAttr(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+
+	return ""
+}
+
+func (c *Client) extractCanonical(linkTags []*html.Node, siteURL string) string {
+	for _, link := range linkTags {
+		if linkAttr(link, "rel") == "canonical" {
+			if href := linkAttr(link, "href"); href != "" {
+				return c.resolveURL(href, siteURL)
+			}
+		}
+	}
+
+	return ""
+}
+
+func (c *Client) extractFavicon(linkTags []*html.Node) string {
+	var appleTouchIcon string
+
+	for _, link := range linkTags {
+		rel := linkAttr(link, "rel")
+
+		if rel == "icon" || rel == "shortcut icon" {
+			return linkAttr(link, "href")
+		}
+
+		if rel == "apple-touch-icon" {
+			appleTouchIcon = linkAttr(link, "href")
+		}
+	}
+
+	return appleTouchIcon
+}
+
+func (c *Client) extractLang(doc *html.Node) string {
+	var htmlTag *html.Node
+
+	var find func(node *html.Node)
+	find = func(node *html.Node) {
+		if htmlTag != nil {
+			return
+		}
+
+		if node.Type == html.ElementNode && node.Data == "html" {
+			htmlTag = node
+			return
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			find(child)
+		}
+	}
+	find(doc)
+
+	if htmlTag == nil {
+		return ""
+	}
+
+	return linkAttr(htmlTag, "lang")
+}
+
+// extractContent applies a simplified readability heuristic: it collects the
+// paragraphs under <article> if present, otherwise it falls back to the
+// <body>'s paragraphs, and joins the ones long enough to plausibly be part
+// of the main copy rather than boilerplate (nav links, captions, etc).
+func (c *Client) extractContent(doc *html.Node) string {
+	root := findArticleTag(doc)
+	if root == nil {
+		root = findBodyTag(doc)
+	}
+	if root == nil {
+		return ""
+	}
+
+	var paragraphs []string
+	collectParagraphText(root, &paragraphs)
+
+	var kept []string
+	for _, p := range paragraphs {
+		if len(p) >= 40 {
+			kept = append(kept, p)
+		}
+	}
+
+	return strings.Join(kept, "\n\n")
+}
+
+func findArticleTag(node *html.Node) *html.Node {
+	if node.Type == html.ElementNode && node.Data == "article" {
+		return node
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if article := findArticleTag(child); article != nil {
+			return article
+		}
+	}
+
+	return nil
+}
+
+func findBodyTag(node *html.Node) *html.Node {
+	if node.Type == html.ElementNode && node.Data == "body" {
+		return node
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if body := findBodyTag(child); body != nil {
+			return body
+		}
+	}
+
+	return nil
+}
+
+func collectParagraphText(node *html.Node, out *[]string) {
+	if node.Type == html.ElementNode && node.Data == "p" {
+		*out = append(*out, strings.TrimSpace(textContent(node)))
+		return
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		collectParagraphText(child, out)
+	}
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+
+	var sb strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		sb.WriteString(textContent(child))
+	}
+
+	return sb.String()
+}
+
+// mergeSiteMeta overlays the fields of override onto base, keeping base's
+// value wherever override left a field empty. URL and Canonical are the
+// exception: they always take override's value, even when it is empty. It's
+// used when a canonical URL is re-fetched and its metadata takes priority
+// over the original page.
+func mergeSiteMeta(base, override *SiteMeta) *SiteMeta {
+	merged := *base
+
+	if override.Title != "" {
+		merged.Title = override.Title
+	}
+	if override.Description != "" {
+		merged.Description = override.Description
+	}
+	if override.Image != "" {
+		merged.Image = override.Image
+	}
+	if override.Author != "" {
+		merged.Author = override.Author
+	}
+	if override.SiteName != "" {
+		merged.SiteName = override.SiteName
+	}
+	if override.Favicon != "" {
+		merged.Favicon = override.Favicon
+	}
+	if override.Lang != "" {
+		merged.Lang = override.Lang
+	}
+	if override.PublishedTime != "" {
+		merged.PublishedTime = override.PublishedTime
+	}
+	if override.Content != "" {
+		merged.Content = override.Content
+	}
+	if override.Structured != nil {
+		merged.Structured = override.Structured
+	}
+
+	merged.URL = override.URL
+	merged.Canonical = override.Canonical
+
+	return &merged
+}
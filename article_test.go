@@ -0,0 +1,60 @@
+package sitemeta
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestExtractCanonical(t *testing.T) {
+	client := NewClient(nil)
+	doc, err := html.Parse(strings.NewReader(`<html><head><link rel="canonical" href="/posts/1"></head></html>`))
+	if err != nil {
+		t.Fatalf("failed to parse HTML: %v", err)
+	}
+
+	meta := client.parseSiteMeta(doc, "https://example.com/posts/1?utm_source=x")
+	if meta.Canonical != "https://example.com/posts/1" {
+		t.Errorf("Expected canonical 'https://example.com/posts/1', got '%s'", meta.Canonical)
+	}
+}
+
+func TestExtractFavicon(t *testing.T) {
+	client := NewClient(nil)
+	doc, err := html.Parse(strings.NewReader(`<html><head><link rel="apple-touch-icon" href="/apple.png"><link rel="icon" href="/favicon.ico"></head></html>`))
+	if err != nil {
+		t.Fatalf("failed to parse HTML: %v", err)
+	}
+
+	meta := client.parseSiteMeta(doc, "https://example.com")
+	if meta.Favicon != "https://example.com/favicon.ico" {
+		t.Errorf("Expected favicon 'https://example.com/favicon.ico', got '%s'", meta.Favicon)
+	}
+}
+
+func TestMergeSiteMeta(t *testing.T) {
+	base := &SiteMeta{URL: "https://example.com", Title: "Original", Author: "Jane"}
+	override := &SiteMeta{URL: "https://example.com/canonical", Title: "Canonical Title"}
+
+	merged := mergeSiteMeta(base, override)
+	if merged.Title != "Canonical Title" {
+		t.Errorf("Expected title to be overridden, got '%s'", merged.Title)
+	}
+	if merged.Author != "Jane" {
+		t.Errorf("Expected author to be preserved from base, got '%s'", merged.Author)
+	}
+	if merged.URL != "https://example.com/canonical" {
+		t.Errorf("Expected URL to be overridden, got '%s'", merged.URL)
+	}
+}
+
+func TestMergeSiteMetaOverridesStructured(t *testing.T) {
+	base := &SiteMeta{Structured: map[string]any{"@type": "Original"}}
+	override := &SiteMeta{Structured: map[string]any{"@type": "Canonical"}}
+
+	merged := mergeSiteMeta(base, override)
+	if merged.Structured["@type"] != "Canonical" {
+		t.Errorf("Expected Structured to be overridden by the canonical page's data, got '%v'", merged.Structured)
+	}
+}
@@ -0,0 +1,121 @@
+package sitemeta
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Result is what GetSiteMetaBatch streams back for each URL as it completes.
+type Result struct {
+	URL  string
+	Meta *SiteMeta
+	Err  error
+}
+
+// BatchOptions configures GetSiteMetaBatch.
+type BatchOptions struct {
+	// Concurrency is the number of URLs processed in parallel. Defaults to 5.
+	Concurrency int
+	// RequestTimeout, if positive, limits how long the batch waits for each
+	// URL. It is applied on top of Config.HTTPTimeout, which still bounds
+	// the HTTP fetch itself, so it can shorten but not lengthen that limit.
+	// Zero adds no per-URL limit beyond ctx.
+	RequestTimeout time.Duration
+	// OnProgress, if set, is called after each URL completes with the
+	// number done so far and the total batch size.
+	OnProgress func(done, total int)
+}
+
+// GetSiteMetaBatch runs GetSiteMeta over urls using a bounded worker pool
+// and streams results as they complete. Requests to the same host are
+// spaced out by the client's per-host rate limiter whenever a politeness
+// interval applies (Config.PerHostMinInterval or a robots.txt Crawl-delay);
+// with neither set, or with Config.IgnoreRobots, they run concurrently. The
+// returned channel is closed once every URL has been processed or ctx is
+// cancelled.
+func (c *Client) GetSiteMetaBatch(ctx context.Context, urls []string, opts BatchOptions) <-chan Result {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 5
+	}
+
+	results := make(chan Result)
+
+	go func() {
+		defer close(results)
+
+		jobs := make(chan string)
+		go func() {
+			defer close(jobs)
+			for _, u := range urls {
+				select {
+				case <-ctx.Done():
+					return
+				case jobs <- u:
+				}
+			}
+		}()
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		done := 0
+		total := len(urls)
+
+		for i := 0; i < opts.Concurrency; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for u := range jobs {
+					meta, err := c.getSiteMetaWithContext(ctx, u, opts.RequestTimeout)
+
+					select {
+					case results <- Result{URL: u, Meta: meta, Err: err}:
+					case <-ctx.Done():
+						return
+					}
+
+					if opts.OnProgress != nil {
+						mu.Lock()
+						done++
+						opts.OnProgress(done, total)
+						mu.Unlock()
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+	}()
+
+	return results
+}
+
+// getSiteMetaWithContext races GetSiteMeta against ctx (and an optional
+// per-request timeout), so a slow host can't stall the whole batch past
+// the caller's deadline.
+func (c *Client) getSiteMetaWithContext(ctx context.Context, websiteURL string, timeout time.Duration) (*SiteMeta, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	type outcome struct {
+		meta *SiteMeta
+		err  error
+	}
+
+	ch := make(chan outcome, 1)
+	go func() {
+		meta, err := c.GetSiteMeta(websiteURL)
+		ch <- outcome{meta, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case o := <-ch:
+		return o.meta, o.err
+	}
+}
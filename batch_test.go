@@ -0,0 +1,44 @@
+package sitemeta
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetSiteMetaBatchStreamsAllResults(t *testing.T) {
+	client := NewClient(nil)
+	urls := []string{"http//bad-1", "http//bad-2", "http//bad-3"}
+
+	results := client.GetSiteMetaBatch(context.Background(), urls, BatchOptions{Concurrency: 2})
+
+	seen := map[string]bool{}
+	for r := range results {
+		if r.Err == nil {
+			t.Errorf("Expected error for invalid URL %s", r.URL)
+		}
+		seen[r.URL] = true
+	}
+
+	for _, u := range urls {
+		if !seen[u] {
+			t.Errorf("Expected a result for %s", u)
+		}
+	}
+}
+
+func TestGetSiteMetaBatchRespectsCancellation(t *testing.T) {
+	client := NewClient(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := client.GetSiteMetaBatch(ctx, []string{"http//bad"}, BatchOptions{})
+
+	count := 0
+	for range results {
+		count++
+	}
+
+	if count > 1 {
+		t.Errorf("Expected at most one result after cancellation, got %d", count)
+	}
+}
@@ -0,0 +1,189 @@
+package sitemeta
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// CacheEntry is what a Cache stores per URL: the extracted SiteMeta plus the
+// HTTP validators needed to revalidate it cheaply once it goes stale.
+type CacheEntry struct {
+	Meta         *SiteMeta
+	ETag         string
+	LastModified string
+	ExpiresAt    time.Time
+}
+
+func (e *CacheEntry) fresh() bool {
+	return e != nil && time.Now().Before(e.ExpiresAt)
+}
+
+// Cache is implemented by GetSiteMeta's response cache. Set stamps ttl onto
+// the entry itself (as ExpiresAt) so implementations don't need to track it
+// separately.
+type Cache interface {
+	Get(url string) (*CacheEntry, bool)
+	Set(url string, entry *CacheEntry, ttl time.Duration)
+}
+
+// extractMetaWithCache is extractMetaWithHTTP's path when Config.Cache is
+// set: serve fresh entries directly, revalidate stale ones with conditional
+// request headers, and fall back to a plain fetch on a cache miss.
+func (c *Client) extractMetaWithCache(websiteURL string) (*SiteMeta, error) {
+	entry, ok := c.config.Cache.Get(websiteURL)
+	if ok && entry.fresh() {
+		return entry.Meta, nil
+	}
+
+	etag, lastModified := "", ""
+	if ok {
+		etag, lastModified = entry.ETag, entry.LastModified
+	}
+
+	doc, validators, err := c.fetchHTML(websiteURL, etag, lastModified)
+	if err != nil {
+		return nil, err
+	}
+
+	if validators.NotModified {
+		if !ok {
+			return nil, fmt.Errorf("server returned 304 Not Modified for an uncached URL: %s", websiteURL)
+		}
+
+		c.config.Cache.Set(websiteURL, &CacheEntry{
+			Meta:         entry.Meta,
+			ETag:         entry.ETag,
+			LastModified: entry.LastModified,
+		}, c.config.CacheTTL)
+
+		return entry.Meta, nil
+	}
+
+	meta := c.parseSiteMeta(doc, websiteURL)
+	c.config.Cache.Set(websiteURL, &CacheEntry{
+		Meta:         meta,
+		ETag:         validators.ETag,
+		LastModified: validators.LastModified,
+	}, c.config.CacheTTL)
+
+	return meta, nil
+}
+
+type memoryCacheItem struct {
+	url   string
+	entry *CacheEntry
+}
+
+// MemoryCache is a fixed-capacity in-memory LRU Cache.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+// NewMemoryCache returns a MemoryCache holding at most capacity entries,
+// evicting the least recently used once full.
+func NewMemoryCache(capacity int) *MemoryCache {
+	if capacity <= 0 {
+		capacity = 100
+	}
+
+	return &MemoryCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (m *MemoryCache) Get(url string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.items[url]
+	if !ok {
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+
+	return elem.Value.(*memoryCacheItem).entry, true
+}
+
+func (m *MemoryCache) Set(url string, entry *CacheEntry, ttl time.Duration) {
+	entry.ExpiresAt = time.Now().Add(ttl)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[url]; ok {
+		elem.Value.(*memoryCacheItem).entry = entry
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	elem := m.order.PushFront(&memoryCacheItem{url: url, entry: entry})
+	m.items[url] = elem
+
+	if m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		if oldest != nil {
+			m.order.Remove(oldest)
+			delete(m.items, oldest.Value.(*memoryCacheItem).url)
+		}
+	}
+}
+
+// FileCache persists cache entries as JSON blobs on disk, one file per URL
+// named by the SHA-256 hash of the URL. It's meant for single-process CLIs
+// and scripts that want cache hits to survive a restart.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache returns a FileCache that stores its blobs under dir, creating
+// it if necessary.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+func (f *FileCache) Get(url string) (*CacheEntry, bool) {
+	data, err := os.ReadFile(f.path(url))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+func (f *FileCache) Set(url string, entry *CacheEntry, ttl time.Duration) {
+	entry.ExpiresAt = time.Now().Add(ttl)
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	_ = os.WriteFile(f.path(url), data, 0o644)
+}
+
+func (f *FileCache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,78 @@
+package sitemeta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheGetSet(t *testing.T) {
+	cache := NewMemoryCache(2)
+	meta := &SiteMeta{URL: "https://example.com", Title: "Example"}
+
+	cache.Set("https://example.com", &CacheEntry{Meta: meta}, time.Minute)
+
+	entry, ok := cache.Get("https://example.com")
+	if !ok {
+		t.Fatal("Expected cache hit")
+	}
+	if entry.Meta.Title != "Example" {
+		t.Errorf("Expected title 'Example', got '%s'", entry.Meta.Title)
+	}
+	if !entry.fresh() {
+		t.Error("Expected entry to be fresh")
+	}
+}
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", &CacheEntry{Meta: &SiteMeta{URL: "a"}}, time.Minute)
+	cache.Set("b", &CacheEntry{Meta: &SiteMeta{URL: "b"}}, time.Minute)
+	cache.Get("a") // touch "a" so "b" becomes least recently used
+	cache.Set("c", &CacheEntry{Meta: &SiteMeta{URL: "c"}}, time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected 'b' to have been evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("Expected 'a' to still be cached")
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Error("Expected 'c' to be cached")
+	}
+}
+
+func TestExtractMetaWithCacheReturnsErrorOn304WithoutCachedEntry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+
+	client := NewClient(&Config{Cache: NewMemoryCache(10)})
+
+	_, err := client.extractMetaWithCache(server.URL)
+	if err == nil {
+		t.Fatal("Expected an error for a 304 response with no cached entry, got nil")
+	}
+}
+
+func TestFileCacheGetSet(t *testing.T) {
+	cache, err := NewFileCache(filepath.Join(t.TempDir(), "sitemeta-cache"))
+	if err != nil {
+		t.Fatalf("NewFileCache failed: %v", err)
+	}
+
+	meta := &SiteMeta{URL: "https://example.com", Title: "Example"}
+	cache.Set("https://example.com", &CacheEntry{Meta: meta, ETag: `"abc"`}, time.Minute)
+
+	entry, ok := cache.Get("https://example.com")
+	if !ok {
+		t.Fatal("Expected cache hit")
+	}
+	if entry.Meta.Title != "Example" || entry.ETag != `"abc"` {
+		t.Errorf("Unexpected cache entry: %+v", entry)
+	}
+}
@@ -0,0 +1,36 @@
+package sitemeta
+
+import (
+	"bytes"
+	"fmt"
+
+	"golang.org/x/net/html/charset"
+)
+
+// decodeToUTF8 transcodes an HTML response body to UTF-8 so html.Parse
+// doesn't mangle pages served in other encodings. charset.DetermineEncoding
+// already implements the right priority order for us: a BOM, then the
+// Content-Type header's charset param, then sniffing a <meta charset> (or
+// <meta http-equiv="Content-Type">) tag in the first KB of the body, then
+// UTF-8 if that first KB is valid UTF-8, and finally falling back to
+// windows-1252 per the HTML5 spec.
+func decodeToUTF8(body []byte, contentType string) ([]byte, error) {
+	enc, name, _ := charset.DetermineEncoding(body, contentType)
+
+	decoded := body
+	if name != "utf-8" {
+		var err error
+		decoded, err = enc.NewDecoder().Bytes(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s body: %w", name, err)
+		}
+	}
+
+	// A BOM (whether sniffed to transcode from, like UTF-16, or already
+	// UTF-8) survives as a literal U+FEFF rune at the start of the decoded
+	// bytes; strip it so it doesn't end up in extracted fields like Title
+	// or Description.
+	decoded = bytes.TrimPrefix(decoded, []byte("\ufeff"))
+
+	return decoded, nil
+}
@@ -0,0 +1,57 @@
+package sitemeta
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeToUTF8PassesThroughUTF8(t *testing.T) {
+	decoded, err := decodeToUTF8([]byte("hello"), "text/html; charset=utf-8")
+	if err != nil {
+		t.Fatalf("decodeToUTF8 failed: %v", err)
+	}
+
+	if string(decoded) != "hello" {
+		t.Errorf("Expected 'hello', got '%s'", decoded)
+	}
+}
+
+func TestDecodeToUTF8SniffsBOM(t *testing.T) {
+	// UTF-16LE BOM followed by "hi" encoded as two bytes per rune.
+	body := []byte{0xFF, 0xFE, 'h', 0x00, 'i', 0x00}
+
+	decoded, err := decodeToUTF8(body, "")
+	if err != nil {
+		t.Fatalf("decodeToUTF8 failed: %v", err)
+	}
+
+	if string(decoded) != "hi" {
+		t.Errorf("Expected 'hi', got '%q'", decoded)
+	}
+}
+
+func TestDecodeToUTF8StripsBOMFromExplicitUTF8(t *testing.T) {
+	body := append([]byte{0xEF, 0xBB, 0xBF}, []byte("<html><head><title>hi</title></head></html>")...)
+
+	decoded, err := decodeToUTF8(body, "text/html; charset=utf-8")
+	if err != nil {
+		t.Fatalf("decodeToUTF8 failed: %v", err)
+	}
+
+	if strings.HasPrefix(string(decoded), "\ufeff") {
+		t.Errorf("Expected BOM to be stripped, got '%q'", decoded)
+	}
+}
+
+func TestDecodeToUTF8UsesMetaCharsetFallback(t *testing.T) {
+	body := []byte(`<html><head><meta charset="utf-8"></head><body>café</body></html>`)
+
+	decoded, err := decodeToUTF8(body, "")
+	if err != nil {
+		t.Fatalf("decodeToUTF8 failed: %v", err)
+	}
+
+	if !strings.Contains(string(decoded), "café") {
+		t.Errorf("Expected decoded body to contain 'café', got '%s'", decoded)
+	}
+}
@@ -0,0 +1,103 @@
+package sitemeta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chromedp/chromedp"
+	"golang.org/x/net/html"
+)
+
+// Renderer drives a browser to a URL and returns its final rendered HTML.
+// The default implementation uses chromedp, but tests or alternative
+// backends (Playwright, a remote CDP endpoint) can supply their own.
+type Renderer interface {
+	Render(ctx context.Context, websiteURL string) (string, error)
+}
+
+// ScreenshotRenderer is an optional capability a Renderer can also provide:
+// capturing a screenshot in the same browser round-trip used to render the
+// page. It's a separate interface from Renderer (rather than a required
+// method) because not every backend can produce one — a remote CDP proxy
+// that only exposes the DOM, for instance — so GetSiteMetaWithScreenshot
+// type-asserts for it instead of requiring every Renderer to implement it.
+type ScreenshotRenderer interface {
+	Screenshot(ctx context.Context, websiteURL string, opts ScreenshotOptions) (htmlStr string, image []byte, err error)
+}
+
+// chromedpRenderer is the default Renderer, driving a local headless Chrome
+// instance via chromedp.
+type chromedpRenderer struct {
+	timeout  time.Duration
+	waitTime time.Duration
+}
+
+func (r *chromedpRenderer) Render(ctx context.Context, websiteURL string) (string, error) {
+	ctx, cancel := chromedp.NewContext(ctx)
+	defer cancel()
+
+	ctx, cancel = context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var htmlStr string
+	err := chromedp.Run(ctx,
+		chromedp.Navigate(websiteURL),
+		chromedp.WaitReady("body", chromedp.ByQuery),
+		chromedp.Sleep(r.waitTime),
+		chromedp.OuterHTML("html", &htmlStr, chromedp.ByQuery),
+	)
+	if err != nil {
+		return "", fmt.Errorf("chrome rendering failed: %w", err)
+	}
+
+	return htmlStr, nil
+}
+
+// Screenshot implements ScreenshotRenderer for chromedpRenderer, capturing
+// the page's outer HTML and a screenshot in a single chromedp run.
+func (r *chromedpRenderer) Screenshot(ctx context.Context, websiteURL string, opts ScreenshotOptions) (string, []byte, error) {
+	ctx, cancel := chromedp.NewContext(ctx)
+	defer cancel()
+
+	ctx, cancel = context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var htmlStr string
+	var png []byte
+
+	tasks := chromedp.Tasks{
+		chromedp.EmulateViewport(opts.Width, opts.Height),
+		chromedp.Navigate(websiteURL),
+		chromedp.WaitReady("body", chromedp.ByQuery),
+		chromedp.Sleep(r.waitTime),
+		chromedp.OuterHTML("html", &htmlStr, chromedp.ByQuery),
+	}
+
+	if opts.FullPage {
+		tasks = append(tasks, chromedp.FullScreenshot(&png, 100))
+	} else {
+		tasks = append(tasks, chromedp.CaptureScreenshot(&png))
+	}
+
+	if err := chromedp.Run(ctx, tasks); err != nil {
+		return "", nil, fmt.Errorf("chrome screenshot failed: %w", err)
+	}
+
+	return htmlStr, png, nil
+}
+
+func (c *Client) renderDOMWithChrome(target string) (*html.Node, error) {
+	htmlStr, err := c.renderer.Render(context.Background(), target)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := html.Parse(bytes.NewReader([]byte(htmlStr)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rendered HTML: %w", err)
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,27 @@
+package sitemeta
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeRenderer struct {
+	html string
+}
+
+func (f *fakeRenderer) Render(ctx context.Context, websiteURL string) (string, error) {
+	return f.html, nil
+}
+
+func TestRenderDOMWithChromeUsesConfiguredRenderer(t *testing.T) {
+	client := NewClient(&Config{Renderer: &fakeRenderer{html: `<html><head><title>Rendered</title></head></html>`}})
+
+	meta, err := client.extractMetaWithChromedp("https://example.com")
+	if err != nil {
+		t.Fatalf("extractMetaWithChromedp failed: %v", err)
+	}
+
+	if meta.Title != "Rendered" {
+		t.Errorf("Expected title 'Rendered', got '%s'", meta.Title)
+	}
+}
@@ -0,0 +1,285 @@
+package sitemeta
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrDisallowedByRobots is returned when a URL's host disallows the
+// configured UserAgent from fetching the requested path.
+type ErrDisallowedByRobots struct {
+	URL string
+}
+
+func (e *ErrDisallowedByRobots) Error() string {
+	return fmt.Sprintf("disallowed by robots.txt: %s", e.URL)
+}
+
+// robotsRules holds the directives that apply to our UserAgent for a host.
+type robotsRules struct {
+	disallow   []string
+	allow      []string
+	crawlDelay time.Duration
+}
+
+func (r *robotsRules) allows(path string) bool {
+	if r == nil {
+		return true
+	}
+
+	longestAllow := longestMatch(r.allow, path)
+	longestDisallow := longestMatch(r.disallow, path)
+
+	if longestDisallow == -1 {
+		return true
+	}
+
+	return longestAllow >= longestDisallow
+}
+
+// longestMatch returns the length of the longest prefix in patterns that
+// matches path, or -1 if none match. Empty patterns never match.
+func longestMatch(patterns []string, path string) int {
+	best := -1
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		if strings.HasPrefix(path, pattern) && len(pattern) > best {
+			best = len(pattern)
+		}
+	}
+
+	return best
+}
+
+type robotsCacheEntry struct {
+	rules     *robotsRules
+	fetchedAt time.Time
+}
+
+// robotsCache caches parsed robots.txt rules per host so bulk extraction
+// doesn't refetch /robots.txt on every call. It is safe for concurrent use.
+type robotsCache struct {
+	mu      sync.Mutex
+	entries map[string]robotsCacheEntry
+}
+
+func newRobotsCache() *robotsCache {
+	return &robotsCache{entries: make(map[string]robotsCacheEntry)}
+}
+
+func (rc *robotsCache) get(host string, ttl time.Duration) (*robotsRules, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[host]
+	if !ok || time.Since(entry.fetchedAt) > ttl {
+		return nil, false
+	}
+
+	return entry.rules, true
+}
+
+func (rc *robotsCache) set(host string, rules *robotsRules) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.entries[host] = robotsCacheEntry{rules: rules, fetchedAt: time.Now()}
+}
+
+// hostRateLimiter enforces a minimum interval between requests to the same
+// host, whether from robots.txt's Crawl-delay or Config.PerHostMinInterval.
+type hostRateLimiter struct {
+	mu   sync.Mutex
+	next map[string]time.Time
+}
+
+func newHostRateLimiter() *hostRateLimiter {
+	return &hostRateLimiter{next: make(map[string]time.Time)}
+}
+
+func (l *hostRateLimiter) wait(host string, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	wait := time.Duration(0)
+	if until, ok := l.next[host]; ok && until.After(now) {
+		wait = until.Sub(now)
+	}
+	l.next[host] = now.Add(wait + interval)
+	l.mu.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// enforceRobots checks robots.txt for parsedURL's host (fetching and caching
+// it if needed), returns ErrDisallowedByRobots if the path is off-limits,
+// and otherwise blocks until the host's politeness interval has elapsed.
+func (c *Client) enforceRobots(parsedURL *url.URL) error {
+	if c.config.IgnoreRobots {
+		return nil
+	}
+
+	rules, err := c.getRobotsRules(parsedURL)
+	if err != nil {
+		// A robots.txt that can't be fetched or parsed shouldn't block
+		// extraction; treat the host as unrestricted.
+		rules = nil
+	}
+
+	path := parsedURL.Path
+	if path == "" {
+		path = "/"
+	}
+
+	if !rules.allows(path) {
+		return &ErrDisallowedByRobots{URL: parsedURL.String()}
+	}
+
+	interval := c.config.PerHostMinInterval
+	if rules != nil && rules.crawlDelay > interval {
+		interval = rules.crawlDelay
+	}
+
+	c.hostLimiter.wait(parsedURL.Host, interval)
+
+	return nil
+}
+
+func (c *Client) getRobotsRules(parsedURL *url.URL) (*robotsRules, error) {
+	host := parsedURL.Host
+
+	if rules, ok := c.robotsCache.get(host, c.config.RobotsCacheTTL); ok {
+		return rules, nil
+	}
+
+	robotsURL := url.URL{Scheme: parsedURL.Scheme, Host: host, Path: "/robots.txt"}
+	userAgent := c.userAgents.Next()
+
+	req, err := http.NewRequest("GET", robotsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var rules *robotsRules
+	if resp.StatusCode == http.StatusOK {
+		rules, err = parseRobotsTxt(resp.Body, userAgent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.robotsCache.set(host, rules)
+
+	return rules, nil
+}
+
+// parseRobotsTxt reads a robots.txt body and returns the Disallow/Allow/
+// Crawl-delay directives of the groups that name userAgent (combined, when
+// more than one does), falling back to "User-agent: *". A group may list
+// several User-agent lines before its rules. Per the robots.txt spec, a
+// group's "User-agent:" value is a product token, not the whole UA string,
+// so a group matches whenever that token appears anywhere in userAgent
+// (e.g. "User-agent: Googlebot" matches
+// "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)").
+func parseRobotsTxt(body io.Reader, userAgent string) (*robotsRules, error) {
+	lowerUA := strings.ToLower(userAgent)
+
+	var (
+		wildcard      = &robotsRules{}
+		specific      = &robotsRules{}
+		currentTarget *robotsRules
+		haveSpecific  bool
+		inUserAgents  bool
+	)
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := splitRobotsLine(line)
+		if !ok {
+			continue
+		}
+
+		if key != "user-agent" {
+			inUserAgents = false
+		}
+
+		switch key {
+		case "user-agent":
+			// Consecutive User-agent lines share the group that follows them.
+			if !inUserAgents {
+				currentTarget = nil
+			}
+			inUserAgents = true
+
+			value = strings.ToLower(value)
+			switch {
+			case value == "*":
+				if currentTarget == nil {
+					currentTarget = wildcard
+				}
+			case value != "" && lowerUA != "" && strings.Contains(lowerUA, value):
+				currentTarget = specific
+				haveSpecific = true
+			}
+		case "disallow":
+			if currentTarget != nil {
+				currentTarget.disallow = append(currentTarget.disallow, value)
+			}
+		case "allow":
+			if currentTarget != nil {
+				currentTarget.allow = append(currentTarget.allow, value)
+			}
+		case "crawl-delay":
+			if currentTarget != nil {
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+					currentTarget.crawlDelay = time.Duration(seconds * float64(time.Second))
+				}
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if haveSpecific {
+		return specific, nil
+	}
+
+	return wildcard, nil
+}
+
+func splitRobotsLine(line string) (key, value string, ok bool) {
+	parts := strings.SplitN(line, ":", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	return strings.ToLower(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1]), true
+}
@@ -0,0 +1,101 @@
+package sitemeta
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRobotsTxtDisallow(t *testing.T) {
+	body := "User-agent: *\nDisallow: /private\nCrawl-delay: 2\n"
+
+	rules, err := parseRobotsTxt(strings.NewReader(body), "Googlebot")
+	if err != nil {
+		t.Fatalf("parseRobotsTxt failed: %v", err)
+	}
+
+	if rules.allows("/private/data") {
+		t.Error("Expected /private/data to be disallowed")
+	}
+
+	if !rules.allows("/public") {
+		t.Error("Expected /public to be allowed")
+	}
+
+	if rules.crawlDelay.Seconds() != 2 {
+		t.Errorf("Expected crawl delay of 2s, got %v", rules.crawlDelay)
+	}
+}
+
+func TestParseRobotsTxtSpecificAgentOverridesWildcard(t *testing.T) {
+	body := "User-agent: *\nDisallow: /\n\nUser-agent: Googlebot\nDisallow: /private\n"
+
+	rules, err := parseRobotsTxt(strings.NewReader(body), "Googlebot")
+	if err != nil {
+		t.Fatalf("parseRobotsTxt failed: %v", err)
+	}
+
+	if !rules.allows("/public") {
+		t.Error("Expected /public to be allowed for the Googlebot-specific group")
+	}
+
+	if rules.allows("/private") {
+		t.Error("Expected /private to be disallowed for the Googlebot-specific group")
+	}
+}
+
+func TestParseRobotsTxtWildcardAfterSpecificIsIgnored(t *testing.T) {
+	body := "User-agent: Googlebot\nDisallow: /private\n\nUser-agent: *\nDisallow: /tmp\n"
+
+	rules, err := parseRobotsTxt(strings.NewReader(body), "Googlebot")
+	if err != nil {
+		t.Fatalf("parseRobotsTxt failed: %v", err)
+	}
+
+	if rules.allows("/private") {
+		t.Error("Expected /private to be disallowed by the Googlebot-specific group")
+	}
+
+	if !rules.allows("/tmp") {
+		t.Error("Expected /tmp to be allowed: it only appears in a later wildcard group, which a specific match must ignore")
+	}
+}
+
+func TestParseRobotsTxtMatchesProductTokenWithinFullUserAgentString(t *testing.T) {
+	body := "User-agent: Googlebot\nDisallow: /private\n\nUser-agent: *\nDisallow: /\n"
+	userAgent := "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+
+	rules, err := parseRobotsTxt(strings.NewReader(body), userAgent)
+	if err != nil {
+		t.Fatalf("parseRobotsTxt failed: %v", err)
+	}
+
+	if !rules.allows("/public") {
+		t.Error("Expected /public to be allowed by the Googlebot-specific group, not the wildcard Disallow: /")
+	}
+
+	if rules.allows("/private") {
+		t.Error("Expected /private to be disallowed by the Googlebot-specific group")
+	}
+}
+
+func TestParseRobotsTxtEmptyUserAgentFallsBackToWildcard(t *testing.T) {
+	body := "User-agent: *\nDisallow: /private\n"
+
+	rules, err := parseRobotsTxt(strings.NewReader(body), "")
+	if err != nil {
+		t.Fatalf("parseRobotsTxt failed: %v", err)
+	}
+
+	if rules.allows("/private") {
+		t.Error("Expected /private to be disallowed for the wildcard group")
+	}
+}
+
+func TestErrDisallowedByRobotsMessageContainsURL(t *testing.T) {
+	// Checks only ErrDisallowedByRobots' message format, by constructing
+	// the error directly; enforceRobots itself is not called here.
+	err := &ErrDisallowedByRobots{URL: "https://example.com/private"}
+	if !strings.Contains(err.Error(), "https://example.com/private") {
+		t.Errorf("Expected error message to contain URL, got '%s'", err.Error())
+	}
+}
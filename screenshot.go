@@ -0,0 +1,123 @@
+package sitemeta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	_ "image/png"
+
+	"golang.org/x/net/html"
+)
+
+// ScreenshotFormat selects the encoding GetSiteMetaWithScreenshot returns.
+type ScreenshotFormat string
+
+const (
+	ScreenshotPNG  ScreenshotFormat = "png"
+	ScreenshotJPEG ScreenshotFormat = "jpeg"
+	ScreenshotGIF  ScreenshotFormat = "gif"
+)
+
+// ScreenshotOptions configures GetSiteMetaWithScreenshot.
+type ScreenshotOptions struct {
+	Width    int64
+	Height   int64
+	FullPage bool
+	Format   ScreenshotFormat
+	// Quality is the JPEG encoding quality (1-100). Ignored for PNG/GIF.
+	Quality int
+	// GIFPaletteSize quantizes the screenshot to at most this many colors
+	// when Format is ScreenshotGIF. Defaults to 256 (GIF's max) when 0.
+	GIFPaletteSize int
+}
+
+// DefaultScreenshotOptions returns sane defaults for a desktop link-preview
+// style screenshot.
+func DefaultScreenshotOptions() ScreenshotOptions {
+	return ScreenshotOptions{
+		Width:   1280,
+		Height:  800,
+		Format:  ScreenshotPNG,
+		Quality: 90,
+	}
+}
+
+// GetSiteMetaWithScreenshot extracts SiteMeta and captures a screenshot in
+// the same browser round-trip, so link-preview style callers don't need to
+// render the page twice. It goes through the client's configured Renderer
+// (like GetSiteMeta does), so swapping in a different ScreenshotRenderer —
+// including a fake one in tests — also changes how screenshots are taken.
+// The screenshot always starts as a PNG capture; JPEG/GIF output is
+// produced by re-encoding it afterwards.
+func (c *Client) GetSiteMetaWithScreenshot(websiteURL string, opts ScreenshotOptions) (*SiteMeta, []byte, error) {
+	if opts.Width <= 0 {
+		opts.Width = 1280
+	}
+	if opts.Height <= 0 {
+		opts.Height = 800
+	}
+	if opts.Format == "" {
+		opts.Format = ScreenshotPNG
+	}
+
+	renderer, ok := c.renderer.(ScreenshotRenderer)
+	if !ok {
+		return nil, nil, fmt.Errorf("configured renderer does not support screenshots")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.ChromeTimeout)
+	defer cancel()
+
+	htmlStr, png, err := renderer.Screenshot(ctx, websiteURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := html.Parse(bytes.NewReader([]byte(htmlStr)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse rendered HTML: %w", err)
+	}
+	meta := c.parseSiteMeta(doc, websiteURL)
+
+	if opts.Format == ScreenshotPNG {
+		return meta, png, nil
+	}
+
+	encoded, err := encodeScreenshot(png, opts)
+	if err != nil {
+		return meta, nil, err
+	}
+
+	return meta, encoded, nil
+}
+
+func encodeScreenshot(pngBytes []byte, opts ScreenshotOptions) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(pngBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode screenshot: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	switch opts.Format {
+	case ScreenshotJPEG:
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: opts.Quality}); err != nil {
+			return nil, fmt.Errorf("failed to encode JPEG screenshot: %w", err)
+		}
+	case ScreenshotGIF:
+		numColors := opts.GIFPaletteSize
+		if numColors <= 0 || numColors > 256 {
+			numColors = 256
+		}
+		if err := gif.Encode(&buf, img, &gif.Options{NumColors: numColors}); err != nil {
+			return nil, fmt.Errorf("failed to encode GIF screenshot: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported screenshot format: %s", opts.Format)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,48 @@
+package sitemeta
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeScreenshotRenderer struct {
+	html  string
+	image []byte
+}
+
+func (f *fakeScreenshotRenderer) Render(ctx context.Context, websiteURL string) (string, error) {
+	return f.html, nil
+}
+
+func (f *fakeScreenshotRenderer) Screenshot(ctx context.Context, websiteURL string, opts ScreenshotOptions) (string, []byte, error) {
+	return f.html, f.image, nil
+}
+
+func TestGetSiteMetaWithScreenshotUsesConfiguredRenderer(t *testing.T) {
+	fakePNG := []byte{0x89, 'P', 'N', 'G'} // not a real decodable PNG, only needed for the PNG passthrough path
+	client := NewClient(&Config{
+		Renderer: &fakeScreenshotRenderer{html: `<html><head><title>Shot</title></head></html>`, image: fakePNG},
+	})
+
+	meta, image, err := client.GetSiteMetaWithScreenshot("https://example.com", ScreenshotOptions{Format: ScreenshotPNG})
+	if err != nil {
+		t.Fatalf("GetSiteMetaWithScreenshot failed: %v", err)
+	}
+
+	if meta.Title != "Shot" {
+		t.Errorf("Expected title 'Shot', got '%s'", meta.Title)
+	}
+
+	if string(image) != string(fakePNG) {
+		t.Errorf("Expected the fake renderer's image bytes to be returned untouched for PNG format")
+	}
+}
+
+func TestGetSiteMetaWithScreenshotRejectsNonScreenshotRenderer(t *testing.T) {
+	client := NewClient(&Config{Renderer: &fakeRenderer{html: `<html></html>`}})
+
+	_, _, err := client.GetSiteMetaWithScreenshot("https://example.com", ScreenshotOptions{})
+	if err == nil {
+		t.Error("Expected an error when the configured renderer doesn't support screenshots")
+	}
+}
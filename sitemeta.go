@@ -2,43 +2,68 @@ package sitemeta
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
-	"github.com/chromedp/chromedp"
 	"golang.org/x/net/html"
 )
 
 type Config struct {
-	HTTPTimeout    time.Duration
-	ChromeTimeout  time.Duration
-	ChromeWaitTime time.Duration
-	UserAgent      string
+	HTTPTimeout        time.Duration
+	ChromeTimeout      time.Duration
+	ChromeWaitTime     time.Duration
+	UserAgent          string
+	UserAgents         []string
+	UserAgentProvider  UserAgentProvider
+	FollowCanonical    bool
+	IgnoreRobots       bool
+	PerHostMinInterval time.Duration
+	RobotsCacheTTL     time.Duration
+	Renderer           Renderer
+	Cache              Cache
+	CacheTTL           time.Duration
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		HTTPTimeout:    10 * time.Second,
-		ChromeTimeout:  20 * time.Second,
-		ChromeWaitTime: 1 * time.Second,
-		UserAgent:      "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		HTTPTimeout:        10 * time.Second,
+		ChromeTimeout:      20 * time.Second,
+		ChromeWaitTime:     1 * time.Second,
+		UserAgent:          "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		FollowCanonical:    false,
+		IgnoreRobots:       false,
+		PerHostMinInterval: 0,
+		RobotsCacheTTL:     1 * time.Hour,
+		CacheTTL:           15 * time.Minute,
 	}
 }
 
 type SiteMeta struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Image       string `json:"image"`
-	URL         string `json:"url"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	Image         string         `json:"image"`
+	URL           string         `json:"url"`
+	Author        string         `json:"author"`
+	SiteName      string         `json:"site_name"`
+	Canonical     string         `json:"canonical"`
+	Favicon       string         `json:"favicon"`
+	Lang          string         `json:"lang"`
+	PublishedTime string         `json:"published_time"`
+	Content       string         `json:"content"`
+	Structured    map[string]any `json:"structured"`
 }
 
 type Client struct {
-	config *Config
-	client *http.Client
+	config      *Config
+	client      *http.Client
+	robotsCache *robotsCache
+	hostLimiter *hostRateLimiter
+	userAgents  UserAgentProvider
+	renderer    Renderer
 }
 
 func NewClient(config *Config) *Client {
@@ -46,11 +71,20 @@ func NewClient(config *Config) *Client {
 		config = DefaultConfig()
 	}
 
+	renderer := config.Renderer
+	if renderer == nil {
+		renderer = &chromedpRenderer{timeout: config.ChromeTimeout, waitTime: config.ChromeWaitTime}
+	}
+
 	return &Client{
 		config: config,
 		client: &http.Client{
 			Timeout: config.HTTPTimeout,
 		},
+		robotsCache: newRobotsCache(),
+		hostLimiter: newHostRateLimiter(),
+		userAgents:  userAgentProvider(config),
+		renderer:    renderer,
 	}
 }
 
@@ -64,6 +98,10 @@ func (c *Client) GetSiteMeta(websiteURL string) (*SiteMeta, error) {
 		return nil, fmt.Errorf("invalid URL: %w", err)
 	}
 
+	if err := c.enforceRobots(parsedURL); err != nil {
+		return nil, err
+	}
+
 	meta, err := c.extractMetaWithHTTP(parsedURL.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract metadata with HTTP: %w", err)
@@ -75,7 +113,26 @@ func (c *Client) GetSiteMeta(websiteURL string) (*SiteMeta, error) {
 			fmt.Printf("ChromeDP extraction failed: %v, returning HTTP result\n", err)
 			return meta, nil
 		}
-		return chromedpMeta, nil
+		meta = chromedpMeta
+	}
+
+	if c.config.FollowCanonical && meta.Canonical != "" && meta.Canonical != meta.URL {
+		canonicalURL, err := url.Parse(meta.Canonical)
+		if err != nil {
+			return meta, nil
+		}
+
+		if err := c.enforceRobots(canonicalURL); err != nil {
+			fmt.Printf("canonical URL disallowed: %v, returning original result\n", err)
+			return meta, nil
+		}
+
+		canonicalMeta, err := c.extractMetaWithHTTP(canonicalURL.String())
+		if err != nil {
+			fmt.Printf("canonical URL extraction failed: %v, returning original result\n", err)
+			return meta, nil
+		}
+		meta = mergeSiteMeta(meta, canonicalMeta)
 	}
 
 	return meta, nil
@@ -87,7 +144,11 @@ func GetSiteMeta(websiteURL string) (*SiteMeta, error) {
 }
 
 func (c *Client) extractMetaWithHTTP(websiteURL string) (*SiteMeta, error) {
-	doc, err := c.fetchHTML(websiteURL)
+	if c.config.Cache != nil {
+		return c.extractMetaWithCache(websiteURL)
+	}
+
+	doc, _, err := c.fetchHTML(websiteURL, "", "")
 	if err != nil {
 		return nil, err
 	}
@@ -104,61 +165,67 @@ func (c *Client) extractMetaWithChromedp(websiteURL string) (*SiteMeta, error) {
 	return c.parseSiteMeta(doc, websiteURL), nil
 }
 
-func (c *Client) fetchHTML(websiteURL string) (*html.Node, error) {
+// httpValidators carries the cache validators for a response: either the
+// ones just received (ETag/LastModified), or a signal that the server
+// confirmed the cached copy is still fresh (NotModified).
+type httpValidators struct {
+	ETag         string
+	LastModified string
+	NotModified  bool
+}
+
+// fetchHTML fetches websiteURL and parses it into an *html.Node. If etag or
+// lastModified are set, they're sent as If-None-Match/If-Modified-Since; a
+// 304 response returns a nil doc with validators.NotModified set.
+func (c *Client) fetchHTML(websiteURL, etag, lastModified string) (*html.Node, httpValidators, error) {
 	req, err := http.NewRequest("GET", websiteURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, httpValidators{}, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("User-Agent", c.config.UserAgent)
+	req.Header.Set("User-Agent", c.userAgents.Next())
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch URL: %w", err)
+		return nil, httpValidators{}, fmt.Errorf("failed to fetch URL: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, httpValidators{NotModified: true}, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, httpValidators{}, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, httpValidators{}, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	doc, err := html.Parse(bytes.NewReader(body))
+	body, err = decodeToUTF8(body, resp.Header.Get("Content-Type"))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+		return nil, httpValidators{}, err
 	}
 
-	return doc, nil
-}
-
-func (c *Client) renderDOMWithChrome(target string) (*html.Node, error) {
-	ctx, cancel := chromedp.NewContext(context.Background())
-	defer cancel()
-
-	ctx, cancel = context.WithTimeout(ctx, c.config.ChromeTimeout)
-	defer cancel()
-
-	var htmlStr string
-	err := chromedp.Run(ctx,
-		chromedp.Navigate(target),
-		chromedp.WaitReady("body", chromedp.ByQuery),
-		chromedp.Sleep(c.config.ChromeWaitTime),
-		chromedp.OuterHTML("html", &htmlStr, chromedp.ByQuery),
-	)
+	doc, err := html.Parse(bytes.NewReader(body))
 	if err != nil {
-		return nil, fmt.Errorf("chrome rendering failed: %w", err)
+		return nil, httpValidators{}, fmt.Errorf("failed to parse HTML: %w", err)
 	}
 
-	doc, err := html.Parse(bytes.NewReader([]byte(htmlStr)))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse rendered HTML: %w", err)
+	validators := httpValidators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
 	}
 
-	return doc, nil
+	return doc, validators, nil
 }
 
 func (c *Client) parseSiteMeta(doc *html.Node, siteURL string) *SiteMeta {
@@ -169,17 +236,70 @@ func (c *Client) parseSiteMeta(doc *html.Node, siteURL string) *SiteMeta {
 		return meta
 	}
 
-	titleNode := c.findTitleTag(head)
-	if titleNode != nil && titleNode.FirstChild != nil {
-		meta.Title = titleNode.FirstChild.Data
+	metaTags := c.findMetaTags(head)
+	structured := c.extractStructuredData(doc)
+	microdata := c.extractMicrodata(doc)
+	meta.Structured = structured
+
+	meta.Title = c.extractTitle(metaTags)
+	if meta.Title == "" {
+		meta.Title = stringFromAny(structured, "headline", "name")
+	}
+	if meta.Title == "" {
+		meta.Title = stringFromAny(microdata, "headline", "name")
+	}
+	if meta.Title == "" {
+		if titleNode := c.findTitleTag(head); titleNode != nil {
+			meta.Title = strings.TrimSpace(textContent(titleNode))
+		}
 	}
 
-	metaTags := c.findMetaTags(head)
 	meta.Description = c.extractDescription(metaTags)
-	
-	if img := c.extractImage(metaTags); img != "" {
-		meta.Image = c.resolveImageURL(img, siteURL)
+	if meta.Description == "" {
+		meta.Description = stringFromAny(structured, "description")
+	}
+	if meta.Description == "" {
+		meta.Description = stringFromAny(microdata, "description")
+	}
+
+	img := c.extractImage(metaTags)
+	if img == "" {
+		img = firstImageFromAny(structured)
+	}
+	if img == "" {
+		img = firstImageFromAny(microdata)
+	}
+	if img != "" {
+		meta.Image = c.resolveURL(img, siteURL)
+	}
+
+	meta.Author = c.extractAuthor(metaTags)
+	if meta.Author == "" {
+		meta.Author = authorFromAny(structured)
+	}
+	if meta.Author == "" {
+		meta.Author = authorFromAny(microdata)
+	}
+
+	meta.SiteName = c.extractSiteName(metaTags)
+
+	meta.PublishedTime = c.extractPublishedTime(metaTags)
+	if meta.PublishedTime == "" {
+		meta.PublishedTime = stringFromAny(structured, "datePublished")
 	}
+	if meta.PublishedTime == "" {
+		meta.PublishedTime = stringFromAny(microdata, "datePublished")
+	}
+
+	linkTags := c.findLinkTags(head)
+	meta.Canonical = c.extractCanonical(linkTags, siteURL)
+
+	if favicon := c.extractFavicon(linkTags); favicon != "" {
+		meta.Favicon = c.resolveURL(favicon, siteURL)
+	}
+
+	meta.Lang = c.extractLang(doc)
+	meta.Content = c.extractContent(doc)
 
 	return meta
 }
@@ -266,7 +386,7 @@ func (c *Client) extractImage(metaTags []*html.Node) string {
 	return ""
 }
 
-func (c *Client) resolveImageURL(imageURL, baseURL string) string {
+func (c *Client) resolveURL(imageURL, baseURL string) string {
 	if imageURL == "" {
 		return ""
 	}
@@ -0,0 +1,271 @@
+package sitemeta
+
+import (
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// articleTypes lists the schema.org @type values we treat as "the" subject
+// of a page when several JSON-LD blocks are present.
+var articleTypes = map[string]bool{
+	"article":        true,
+	"newsarticle":    true,
+	"blogposting":    true,
+	"product":        true,
+	"breadcrumblist": true,
+	"organization":   true,
+}
+
+// Article is a typed view over SiteMeta.Structured for the fields callers
+// most commonly want out of a page's JSON-LD/microdata.
+type Article struct {
+	Headline      string   `json:"headline"`
+	Author        string   `json:"author"`
+	DatePublished string   `json:"date_published"`
+	Image         []string `json:"image"`
+}
+
+// GetArticle returns the structured-data fields as a typed Article. It never
+// returns nil; fields are empty if the page had no usable structured data.
+func (m *SiteMeta) GetArticle() *Article {
+	return &Article{
+		Headline:      stringFromAny(m.Structured, "headline", "name"),
+		Author:        authorFromAny(m.Structured),
+		DatePublished: stringFromAny(m.Structured, "datePublished"),
+		Image:         imagesFromAny(m.Structured),
+	}
+}
+
+// extractStructuredData walks the document for <script type="application/ld+json">
+// blocks and returns the first node whose @type matches one of the types we
+// recognize (Article/NewsArticle/BlogPosting/Product/BreadcrumbList/
+// Organization). A
+// document can embed several unrelated JSON-LD graphs; we only surface the
+// one that looks like the page's main subject.
+func (c *Client) extractStructuredData(doc *html.Node) map[string]any {
+	var scripts []*html.Node
+	findScriptTags(doc, &scripts)
+
+	for _, script := range scripts {
+		if linkAttr(script, "type") != "application/ld+json" || script.FirstChild == nil {
+			continue
+		}
+
+		for _, node := range decodeJSONLD(script.FirstChild.Data) {
+			if isRecognizedType(node) {
+				return node
+			}
+		}
+	}
+
+	return nil
+}
+
+func findScriptTags(node *html.Node, out *[]*html.Node) {
+	if node.Type == html.ElementNode && node.Data == "script" {
+		*out = append(*out, node)
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		findScriptTags(child, out)
+	}
+}
+
+// decodeJSONLD parses a JSON-LD payload, which may be a single object, an
+// array of objects, or an object with an "@graph" array, and returns the
+// flat list of nodes it contains.
+func decodeJSONLD(raw string) []map[string]any {
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		if graph, ok := v["@graph"].([]any); ok {
+			return asNodeList(graph)
+		}
+		return []map[string]any{v}
+	case []any:
+		return asNodeList(v)
+	default:
+		return nil
+	}
+}
+
+func asNodeList(items []any) []map[string]any {
+	var nodes []map[string]any
+	for _, item := range items {
+		if node, ok := item.(map[string]any); ok {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+func isRecognizedType(node map[string]any) bool {
+	t, ok := node["@type"].(string)
+	if !ok {
+		return false
+	}
+
+	return articleTypes[strings.ToLower(t)]
+}
+
+// extractMicrodata looks for the first itemscope element whose itemtype
+// matches one of the types we recognize and collects its itemprop values
+// into a flat map. parseSiteMeta uses it field by field, as a fallback for
+// values the JSON-LD data doesn't provide.
+func (c *Client) extractMicrodata(doc *html.Node) map[string]any {
+	scope := findMicrodataScope(doc)
+	if scope == nil {
+		return nil
+	}
+
+	props := map[string]any{}
+	collectItemProps(scope, props)
+
+	return props
+}
+
+func findMicrodataScope(node *html.Node) *html.Node {
+	if node.Type == html.ElementNode && hasAttr(node, "itemscope") {
+		itemType := linkAttr(node, "itemtype")
+		if idx := strings.LastIndex(itemType, "/"); idx != -1 {
+			itemType = itemType[idx+1:]
+		}
+
+		if articleTypes[strings.ToLower(itemType)] {
+			return node
+		}
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if scope := findMicrodataScope(child); scope != nil {
+			return scope
+		}
+	}
+
+	return nil
+}
+
+func collectItemProps(node *html.Node, props map[string]any) {
+	if node.Type == html.ElementNode {
+		if prop := linkAttr(node, "itemprop"); prop != "" {
+			if value := microdataValue(node); value != "" {
+				props[prop] = value
+			}
+		}
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		collectItemProps(child, props)
+	}
+}
+
+// microdataValue extracts an itemprop's value following the HTML spec rules
+// for the subset of elements sitemeta cares about (meta/link content or
+// href, img src, time datetime, otherwise the element's text).
+func microdataValue(node *html.Node) string {
+	switch node.Data {
+	case "meta":
+		return linkAttr(node, "content")
+	case "link", "a":
+		return linkAttr(node, "href")
+	case "img":
+		return linkAttr(node, "src")
+	case "time":
+		if dt := linkAttr(node, "datetime"); dt != "" {
+			return dt
+		}
+	}
+
+	return strings.TrimSpace(textContent(node))
+}
+
+func hasAttr(node *html.Node, key string) bool {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringFromAny reads the first of keys that holds a string value in data.
+func stringFromAny(data map[string]any, keys ...string) string {
+	if data == nil {
+		return ""
+	}
+
+	for _, key := range keys {
+		if s, ok := data[key].(string); ok && s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+// authorFromAny handles schema.org's "author" being either a plain string
+// or a Person/Organization object with a "name" field.
+func authorFromAny(data map[string]any) string {
+	if data == nil {
+		return ""
+	}
+
+	switch author := data["author"].(type) {
+	case string:
+		return author
+	case map[string]any:
+		if name, ok := author["name"].(string); ok {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// imagesFromAny handles schema.org's "image" being a string, an array of
+// strings, or an ImageObject (or array of ImageObjects) with a "url" field.
+func imagesFromAny(data map[string]any) []string {
+	if data == nil {
+		return nil
+	}
+
+	var images []string
+	switch image := data["image"].(type) {
+	case string:
+		images = append(images, image)
+	case map[string]any:
+		if url, ok := image["url"].(string); ok {
+			images = append(images, url)
+		}
+	case []any:
+		for _, item := range image {
+			switch v := item.(type) {
+			case string:
+				images = append(images, v)
+			case map[string]any:
+				if url, ok := v["url"].(string); ok {
+					images = append(images, url)
+				}
+			}
+		}
+	}
+
+	return images
+}
+
+func firstImageFromAny(data map[string]any) string {
+	images := imagesFromAny(data)
+	if len(images) == 0 {
+		return ""
+	}
+
+	return images[0]
+}
@@ -0,0 +1,74 @@
+package sitemeta
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestParseSiteMetaJSONLD(t *testing.T) {
+	client := NewClient(nil)
+	doc, err := html.Parse(strings.NewReader(`<html><head>
+		<script type="application/ld+json">
+		{"@type": "NewsArticle", "headline": "Breaking News", "author": {"@type": "Person", "name": "Jane Doe"}, "datePublished": "2024-01-02", "image": "https://example.com/og.png"}
+		</script>
+	</head></html>`))
+	if err != nil {
+		t.Fatalf("failed to parse HTML: %v", err)
+	}
+
+	meta := client.parseSiteMeta(doc, "https://example.com")
+	if meta.Title != "Breaking News" {
+		t.Errorf("Expected title 'Breaking News', got '%s'", meta.Title)
+	}
+	if meta.Author != "Jane Doe" {
+		t.Errorf("Expected author 'Jane Doe', got '%s'", meta.Author)
+	}
+	if meta.PublishedTime != "2024-01-02" {
+		t.Errorf("Expected published time '2024-01-02', got '%s'", meta.PublishedTime)
+	}
+}
+
+func TestGetArticle(t *testing.T) {
+	meta := &SiteMeta{
+		Structured: map[string]any{
+			"headline":      "A Headline",
+			"datePublished": "2024-05-01",
+			"author":        "John Smith",
+			"image":         []any{"https://example.com/a.png", "https://example.com/b.png"},
+		},
+	}
+
+	article := meta.GetArticle()
+	if article.Headline != "A Headline" {
+		t.Errorf("Expected headline 'A Headline', got '%s'", article.Headline)
+	}
+	if article.Author != "John Smith" {
+		t.Errorf("Expected author 'John Smith', got '%s'", article.Author)
+	}
+	if len(article.Image) != 2 {
+		t.Errorf("Expected 2 images, got %d", len(article.Image))
+	}
+}
+
+func TestExtractMicrodataFallback(t *testing.T) {
+	client := NewClient(nil)
+	doc, err := html.Parse(strings.NewReader(`<html><head></head><body>
+		<div itemscope itemtype="https://schema.org/Article">
+			<span itemprop="headline">Microdata Title</span>
+			<span itemprop="author">Micro Author</span>
+		</div>
+	</body></html>`))
+	if err != nil {
+		t.Fatalf("failed to parse HTML: %v", err)
+	}
+
+	meta := client.parseSiteMeta(doc, "https://example.com")
+	if meta.Title != "Microdata Title" {
+		t.Errorf("Expected title 'Microdata Title', got '%s'", meta.Title)
+	}
+	if meta.Author != "Micro Author" {
+		t.Errorf("Expected author 'Micro Author', got '%s'", meta.Author)
+	}
+}
@@ -0,0 +1,154 @@
+package sitemeta
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// UserAgentProvider supplies a User-Agent string per request. Implement it
+// to plug in custom rotation (weighted, sticky-per-host, etc); the built-in
+// providers cover simple round-robin and random selection.
+type UserAgentProvider interface {
+	Next() string
+}
+
+// staticUserAgent is used when Config only sets a single UserAgent, keeping
+// the pre-rotation behavior unchanged.
+type staticUserAgent string
+
+func (s staticUserAgent) Next() string {
+	return string(s)
+}
+
+// roundRobinUserAgents cycles through a fixed pool in order.
+type roundRobinUserAgents struct {
+	mu     sync.Mutex
+	agents []string
+	next   int
+}
+
+// NewRoundRobinUserAgents returns a UserAgentProvider that cycles through
+// agents in order, wrapping around once it reaches the end.
+func NewRoundRobinUserAgents(agents []string) UserAgentProvider {
+	return &roundRobinUserAgents{agents: agents}
+}
+
+func (r *roundRobinUserAgents) Next() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	agent := r.agents[r.next%len(r.agents)]
+	r.next++
+
+	return agent
+}
+
+// randomUserAgents picks a uniformly random entry from the pool on each call.
+type randomUserAgents struct {
+	mu     sync.Mutex
+	agents []string
+	rng    *rand.Rand
+}
+
+// NewRandomUserAgents returns a UserAgentProvider that picks a random entry
+// from agents on every call.
+func NewRandomUserAgents(agents []string) UserAgentProvider {
+	return &randomUserAgents{agents: agents, rng: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (r *randomUserAgents) Next() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.agents[r.rng.Intn(len(r.agents))]
+}
+
+// weightedUserAgents picks a random entry on each call, biased by weight.
+type weightedUserAgents struct {
+	mu         sync.Mutex
+	agents     []string
+	cumWeights []float64
+	total      float64
+	rng        *rand.Rand
+}
+
+// NewWeightedUserAgents returns a UserAgentProvider that picks a random entry
+// from weights on every call, favoring UAs with a higher weight (e.g. their
+// real-world browser share). Entries with a weight <= 0 are ignored.
+func NewWeightedUserAgents(weights map[string]float64) UserAgentProvider {
+	w := &weightedUserAgents{rng: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+	for agent, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		w.total += weight
+		w.agents = append(w.agents, agent)
+		w.cumWeights = append(w.cumWeights, w.total)
+	}
+
+	return w
+}
+
+func (w *weightedUserAgents) Next() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	target := w.rng.Float64() * w.total
+	idx := sort.Search(len(w.cumWeights), func(i int) bool { return w.cumWeights[i] > target })
+	if idx >= len(w.agents) {
+		idx = len(w.agents) - 1
+	}
+
+	return w.agents[idx]
+}
+
+// RealisticUserAgents returns a small built-in pool of current desktop and
+// mobile User-Agent strings for Chrome, Firefox, and Safari. Many sites
+// block the default Googlebot UserAgent outright; rotating through these
+// makes extraction succeed on more of them.
+func RealisticUserAgents() []string {
+	return []string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+		"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1",
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36",
+	}
+}
+
+// RealisticUserAgentWeights returns the same pool as RealisticUserAgents,
+// weighted by each browser/platform's approximate real-world usage share.
+// Pass it to NewWeightedUserAgents (and set the result as
+// Config.UserAgentProvider) to rotate UAs the way search crawlers do,
+// favoring the most common ones.
+func RealisticUserAgentWeights() map[string]float64 {
+	return map[string]float64{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36":                         35,
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36":                   15,
+		"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36":                                   5,
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0":                                                        6,
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15":                   9,
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1": 18,
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36":                   12,
+	}
+}
+
+// userAgentProvider resolves the Config's UserAgent settings into a single
+// UserAgentProvider, preferring the most specific option set: an explicit
+// UserAgentProvider, then a UserAgents pool, then the plain UserAgent string.
+func userAgentProvider(config *Config) UserAgentProvider {
+	if config.UserAgentProvider != nil {
+		return config.UserAgentProvider
+	}
+
+	if len(config.UserAgents) > 0 {
+		return NewRoundRobinUserAgents(config.UserAgents)
+	}
+
+	return staticUserAgent(config.UserAgent)
+}
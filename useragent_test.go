@@ -0,0 +1,60 @@
+package sitemeta
+
+import "testing"
+
+func TestRoundRobinUserAgents(t *testing.T) {
+	provider := NewRoundRobinUserAgents([]string{"a", "b", "c"})
+
+	got := []string{provider.Next(), provider.Next(), provider.Next(), provider.Next()}
+	want := []string{"a", "b", "c", "a"}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+			break
+		}
+	}
+}
+
+func TestUserAgentProviderFallsBackToStaticUserAgent(t *testing.T) {
+	config := &Config{UserAgent: "Custom/1.0"}
+	provider := userAgentProvider(config)
+
+	if provider.Next() != "Custom/1.0" {
+		t.Errorf("Expected 'Custom/1.0', got '%s'", provider.Next())
+	}
+}
+
+func TestUserAgentProviderPrefersPool(t *testing.T) {
+	config := &Config{UserAgent: "Custom/1.0", UserAgents: []string{"PoolAgent/1.0"}}
+	provider := userAgentProvider(config)
+
+	if provider.Next() != "PoolAgent/1.0" {
+		t.Errorf("Expected 'PoolAgent/1.0', got '%s'", provider.Next())
+	}
+}
+
+func TestRealisticUserAgentsNonEmpty(t *testing.T) {
+	if len(RealisticUserAgents()) == 0 {
+		t.Error("Expected RealisticUserAgents to return a non-empty pool")
+	}
+}
+
+func TestWeightedUserAgentsOnlyReturnsPositiveWeightEntries(t *testing.T) {
+	provider := NewWeightedUserAgents(map[string]float64{"a": 1, "b": 0})
+
+	for i := 0; i < 20; i++ {
+		if got := provider.Next(); got != "a" {
+			t.Fatalf("Expected only 'a' (zero-weight 'b' excluded), got '%s'", got)
+		}
+	}
+}
+
+func TestRealisticUserAgentWeightsMatchesRealisticUserAgentsPool(t *testing.T) {
+	weights := RealisticUserAgentWeights()
+	for _, agent := range RealisticUserAgents() {
+		if _, ok := weights[agent]; !ok {
+			t.Errorf("Expected RealisticUserAgentWeights to have a weight for %q", agent)
+		}
+	}
+}